@@ -21,7 +21,9 @@ type Code interface {
 	String() string
 }
 
-// code is a 3 character string representing a code for a currency
+// code is a string representing a code for a currency. By default it is 3
+// characters long, but a validator registered with RegisterValidator may
+// accept other forms.
 type code string
 
 func (c code) String() string {
@@ -43,12 +45,33 @@ func UnmarshalJSON(data []byte) (*Code, error) {
 	return NewCode(aux)
 }
 
+// validator is used by NewCode to validate codes. When nil, the default
+// validation is used.
+var validator func(string) error
+
+// RegisterValidator replaces the validation used by NewCode with the given
+// function, allowing codes beyond the default rules, such as internal codes,
+// to be accepted. A registered validator can fall back to DefaultValidator for
+// any codes that it does not handle itself. Registering nil restores the
+// default validation.
+// RegisterValidator is not safe to call concurrently with NewCode and should
+// be called during initialisation.
+func RegisterValidator(v func(string) error) {
+	validator = v
+}
+
 // validate returns an error if a code is invalid
 func (c code) validate() error {
-	return validateCodeLengthError(string(c))
+	if validator != nil {
+		return validator(string(c))
+	}
+	return DefaultValidator(string(c))
 }
 
-func validateCodeLengthError(code string) (err error) {
+// DefaultValidator is the validation used by NewCode when no validator has
+// been registered, returning an InvalidCodeLengthError for any code that is
+// not 3 characters long.
+func DefaultValidator(code string) (err error) {
 	if length := len(code); length != 3 {
 		err = InvalidCodeLengthError{length}
 	}
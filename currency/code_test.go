@@ -79,3 +79,37 @@ func TestInvalidCodeLengthError(t *testing.T) {
 	assert.Equal(t, len(invalid), e.Length)
 	assert.Equal(t, fmt.Sprintf("invalid currency code Length (%d)", len(invalid)), err.Error())
 }
+
+func TestRegisterValidator(t *testing.T) {
+	internal := "POINTS"
+	_, err := currency.NewCode(internal)
+	assert.NotNil(t, err)
+
+	currency.RegisterValidator(func(code string) error {
+		if code == internal {
+			return nil
+		}
+		return currency.DefaultValidator(code)
+	})
+	defer currency.RegisterValidator(nil)
+
+	c, err := currency.NewCode(internal)
+	assert.Nil(t, err)
+	assert.Equal(t, internal, (*c).String())
+	_, err = currency.NewCode("YEN")
+	assert.Nil(t, err)
+	_, err = currency.NewCode("QWERTY")
+	assert.IsType(t, currency.InvalidCodeLengthError{}, err)
+
+	currency.RegisterValidator(nil)
+	_, err = currency.NewCode(internal)
+	assert.IsType(t, currency.InvalidCodeLengthError{}, err)
+}
+
+func TestDefaultValidator(t *testing.T) {
+	assert.Nil(t, currency.DefaultValidator("YEN"))
+	for _, code := range []string{"", "PTS1", "QWERTYUIOP"} {
+		err := currency.DefaultValidator(code)
+		assert.Equal(t, currency.InvalidCodeLengthError{Length: len(code)}, err, code)
+	}
+}
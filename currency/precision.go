@@ -0,0 +1,52 @@
+package currency
+
+import "strings"
+
+// defaultPrecision is the number of decimal places used by any currency that
+// does not appear in precisions.
+const defaultPrecision = 2
+
+// precisions holds the number of decimal places for the ISO 4217 currencies
+// whose minor unit is not the default of 2.
+var precisions = map[string]int{
+	"BHD": 3,
+	"BIF": 0,
+	"CLF": 4,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"PYG": 0,
+	"RWF": 0,
+	"TND": 3,
+	"UGX": 0,
+	"UYI": 0,
+	"UYW": 4,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+}
+
+// Precision returns the number of decimal places that the currency's lowest
+// denominator represents.
+// The lookup is case-insensitive and a nil Code uses the default precision.
+// e.g. For GBP, Precision would return 2 and for JPY or jpy, 0.
+func Precision(c Code) int {
+	if c == nil {
+		return defaultPrecision
+	}
+	if p, ok := precisions[strings.ToUpper(c.String())]; ok {
+		return p
+	}
+	return defaultPrecision
+}
@@ -0,0 +1,33 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/glynternet/go-money/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecision(t *testing.T) {
+	for _, test := range []struct {
+		code      string
+		precision int
+	}{
+		{code: "GBP", precision: 2},
+		{code: "EUR", precision: 2},
+		{code: "JPY", precision: 0},
+		{code: "KWD", precision: 3},
+		{code: "jpy", precision: 0},
+		{code: "kwd", precision: 3},
+		{code: "CLF", precision: 4},
+		{code: "UYI", precision: 0},
+		{code: "RIN", precision: 2},
+	} {
+		c, err := currency.NewCode(test.code)
+		assert.Nil(t, err)
+		assert.Equal(t, test.precision, currency.Precision(*c), test.code)
+	}
+}
+
+func TestPrecision_Nil(t *testing.T) {
+	assert.Equal(t, 2, currency.Precision(nil))
+}
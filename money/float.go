@@ -0,0 +1,20 @@
+package money
+
+import (
+	"math"
+
+	"github.com/glynternet/go-money/currency"
+)
+
+// Float64 returns the value of a Money as a decimal float, using the
+// precision of the Money's currency.
+// e.g. For £45.67, Float64 would return 45.67
+// A Money without a currency uses the default precision of the currency
+// package.
+//
+// The conversion is lossy, as not every decimal can be represented exactly by
+// a float64, so the result should only be used for display purposes, such as
+// charting, and never for arithmetic.
+func Float64(m Money) float64 {
+	return float64(m.Amount()) / math.Pow10(currency.Precision(m.Currency()))
+}
@@ -0,0 +1,31 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/glynternet/go-money/currency"
+	"github.com/glynternet/go-money/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat64(t *testing.T) {
+	for _, test := range []struct {
+		amount int
+		code   string
+		float  float64
+	}{
+		{amount: 4567, code: "GBP", float: 45.67},
+		{amount: -5, code: "EUR", float: -0.05},
+		{amount: 0, code: "EUR", float: 0},
+		{amount: 1000, code: "JPY", float: 1000},
+		{amount: 1234, code: "KWD", float: 1.234},
+	} {
+		c, err := currency.NewCode(test.code)
+		assert.Nil(t, err)
+		assert.InDelta(t, test.float, money.Float64(money.New(test.amount, *c)), 1e-9)
+	}
+}
+
+func TestFloat64_NilCurrency(t *testing.T) {
+	assert.InDelta(t, 45.67, money.Float64(money.New(4567, nil)), 1e-9)
+}
@@ -47,4 +47,5 @@ func TestUnmarshalJSON(t *testing.T) {
 	assert.Nil(t, err)
 	_, err = money.UnmarshalJSON(j)
 	assert.NotNil(t, err)
+	assert.IsType(t, currency.InvalidCodeLengthError{}, err)
 }
@@ -0,0 +1,57 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseMoney parses a string of the form "<amount> <code>" into a Money,
+// using the precision of the currency to read the amount's decimal places.
+// The amount may have a leading currency symbol and its whole part may group
+// thousands with commas.
+// e.g. "1,234.56 EUR", "£45.67 GBP" and "¥1000 JPY" are all valid.
+func ParseMoney(s string) (Money, error) {
+	return parseText(s, normaliseDecimal)
+}
+
+// normaliseDecimal removes a single leading currency symbol and any thousands
+// grouping from a decimal string, so that it can be read by parseDecimal.
+func normaliseDecimal(s string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	if r, size := utf8.DecodeRuneInString(s); isCurrencySymbol(r) {
+		s = s[size:]
+	}
+	if sign == "" && strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return "", errors.New("amount must start with a digit after any sign and single currency symbol")
+	}
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i:]
+	}
+	if strings.Contains(whole, ",") {
+		groups := strings.Split(whole, ",")
+		if l := len(groups[0]); l == 0 || l > 3 {
+			return "", fmt.Errorf("invalid thousands grouping in %q", s)
+		}
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return "", fmt.Errorf("invalid thousands grouping in %q", s)
+			}
+		}
+		whole = strings.Join(groups, "")
+	}
+	return sign + whole + fraction, nil
+}
+
+func isCurrencySymbol(r rune) bool {
+	return unicode.Is(unicode.Sc, r)
+}
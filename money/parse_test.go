@@ -0,0 +1,61 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/glynternet/go-money/currency"
+	"github.com/glynternet/go-money/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	for _, test := range []struct {
+		text   string
+		amount int
+		code   string
+	}{
+		{text: "100.50 USD", amount: 10050, code: "USD"},
+		{text: "1,234.56 EUR", amount: 123456, code: "EUR"},
+		{text: "¥1000 JPY", amount: 1000, code: "JPY"},
+		{text: "5 jpy", amount: 5, code: "jpy"},
+		{text: "1.234 kwd", amount: 1234, code: "kwd"},
+		{text: "£1,000,000 GBP", amount: 100000000, code: "GBP"},
+		{text: "-€5.5 EUR", amount: -550, code: "EUR"},
+		{text: "€-5.5 EUR", amount: -550, code: "EUR"},
+		{text: "  12 EUR ", amount: 1200, code: "EUR"},
+	} {
+		m, err := money.ParseMoney(test.text)
+		if !assert.Nil(t, err, test.text) {
+			continue
+		}
+		c, err := currency.NewCode(test.code)
+		assert.Nil(t, err)
+		assert.Equal(t, money.New(test.amount, *c), m, test.text)
+	}
+}
+
+func TestParseMoney_Invalid(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"100.50",
+		"100.50USD",
+		"100.50 US",
+		"1,23.45 EUR",
+		"1234,567 EUR",
+		",123 EUR",
+		"1.234 EUR",
+		"1.5 JPY",
+		"abc EUR",
+		"1.2.3 EUR",
+		"abc5 EUR",
+		"−5 EUR",
+		"+5 EUR",
+		"€€5 EUR",
+		"£€5 EUR",
+		"5£ EUR",
+		"£ EUR",
+	} {
+		_, err := money.ParseMoney(text)
+		assert.NotNil(t, err, text)
+	}
+}
@@ -0,0 +1,27 @@
+package money
+
+import "fmt"
+
+// SplitEqually divides a Money into n parts of the same currency that sum
+// exactly to the original Money. Any remainder of the lowest denominator is
+// distributed, one unit at a time, to the first parts.
+// e.g. For £1.00 split into 3, SplitEqually would return £0.34, £0.33, £0.33
+func SplitEqually(m Money, n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("cannot split money into %d parts", n)
+	}
+	part, remainder := m.Amount()/n, m.Amount()%n
+	unit := 1
+	if remainder < 0 {
+		unit, remainder = -1, -remainder
+	}
+	parts := make([]Money, n)
+	for i := range parts {
+		amount := part
+		if i < remainder {
+			amount += unit
+		}
+		parts[i] = New(amount, m.Currency())
+	}
+	return parts, nil
+}
@@ -0,0 +1,44 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/glynternet/go-money/currency"
+	"github.com/glynternet/go-money/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitEqually(t *testing.T) {
+	c, err := currency.NewCode("EUR")
+	assert.Nil(t, err)
+	for _, test := range []struct {
+		amount int
+		n      int
+		parts  []int
+	}{
+		{amount: 100, n: 4, parts: []int{25, 25, 25, 25}},
+		{amount: 100, n: 3, parts: []int{34, 33, 33}},
+		{amount: -100, n: 3, parts: []int{-34, -33, -33}},
+		{amount: 2, n: 3, parts: []int{1, 1, 0}},
+		{amount: 100, n: 1, parts: []int{100}},
+	} {
+		parts, err := money.SplitEqually(money.New(test.amount, *c), test.n)
+		assert.Nil(t, err)
+		var amounts []int
+		for _, p := range parts {
+			assert.Equal(t, *c, p.Currency())
+			amounts = append(amounts, p.Amount())
+		}
+		assert.Equal(t, test.parts, amounts, "%d/%d", test.amount, test.n)
+	}
+}
+
+func TestSplitEqually_InvalidParts(t *testing.T) {
+	c, err := currency.NewCode("EUR")
+	assert.Nil(t, err)
+	for _, n := range []int{0, -1} {
+		parts, err := money.SplitEqually(money.New(100, *c), n)
+		assert.NotNil(t, err)
+		assert.Nil(t, parts)
+	}
+}
@@ -0,0 +1,115 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/glynternet/go-money/currency"
+)
+
+// MarshalText returns the Money in the form "<decimal> <code>", where the
+// decimal uses the precision of the Money's currency.
+// e.g. For £45.67, MarshalText would return "45.67 GBP"
+// An error is returned if the Money has no currency.
+func (m money) MarshalText() ([]byte, error) {
+	if m.currency == nil {
+		return nil, errors.New("cannot marshal money text without a currency")
+	}
+	return []byte(formatDecimal(m.amount, currency.Precision(m.currency)) + " " + m.currency.String()), nil
+}
+
+// UnmarshalText attempts to unmarshal a []byte of the form produced by
+// MarshalText into a Money, returning the Money, if successful, and an error,
+// if any occurred.
+func UnmarshalText(text []byte) (*Money, error) {
+	pm, err := parseText(string(text), nil)
+	if err != nil {
+		return nil, err
+	}
+	m := new(Money)
+	*m = pm
+	return m, nil
+}
+
+// parseText parses a string of the form "<decimal> <code>" into a Money. If
+// normalise is non-nil, it is applied to the decimal before it is parsed.
+// Any error returned identifies the string being parsed and wraps the
+// underlying cause.
+func parseText(s string, normalise func(string) (string, error)) (Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("invalid money %q: expected \"<amount> <currency>\"", s)
+	}
+	c, err := currency.NewCode(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid money %q: %w", s, err)
+	}
+	decimal := fields[0]
+	if normalise != nil {
+		decimal, err = normalise(decimal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid money %q: %w", s, err)
+		}
+	}
+	amount, err := parseDecimal(decimal, currency.Precision(*c))
+	if err != nil {
+		return nil, fmt.Errorf("invalid money %q: %w", s, err)
+	}
+	return New(amount, *c), nil
+}
+
+// formatDecimal formats an amount of a currency's lowest denominator as a
+// decimal string with the given number of decimal places.
+func formatDecimal(amount, places int) string {
+	sign, digits := "", strconv.Itoa(amount)
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if places == 0 {
+		return sign + digits
+	}
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+	split := len(digits) - places
+	return sign + digits[:split] + "." + digits[split:]
+}
+
+// parseDecimal parses a decimal string into an amount of a currency's lowest
+// denominator, returning an error if the string has more decimal places than
+// given.
+func parseDecimal(s string, places int) (int, error) {
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+		if len(fraction) == 0 {
+			return 0, fmt.Errorf("invalid decimal %q: missing digits after decimal point", s)
+		}
+	}
+	if len(fraction) > places {
+		return 0, fmt.Errorf("invalid decimal %q: more than %d decimal places", s, places)
+	}
+	sign := ""
+	if strings.HasPrefix(whole, "-") {
+		sign, whole = "-", whole[1:]
+	}
+	if !isDigits(whole) || !isDigits(fraction) || len(whole) == 0 {
+		return 0, fmt.Errorf("invalid decimal %q", s)
+	}
+	amount, err := strconv.Atoi(sign + whole + fraction + strings.Repeat("0", places-len(fraction)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid decimal %q: %w", s, err)
+	}
+	return amount, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package money_test
+
+import (
+	"encoding"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/glynternet/go-money/currency"
+	"github.com/glynternet/go-money/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestText(t *testing.T) {
+	for _, test := range []struct {
+		amount int
+		code   string
+		text   string
+	}{
+		{amount: 123456, code: "EUR", text: "1234.56 EUR"},
+		{amount: 5, code: "EUR", text: "0.05 EUR"},
+		{amount: -5, code: "EUR", text: "-0.05 EUR"},
+		{amount: 0, code: "GBP", text: "0.00 GBP"},
+		{amount: 1000, code: "JPY", text: "1000 JPY"},
+		{amount: -1000, code: "JPY", text: "-1000 JPY"},
+		{amount: 1234, code: "KWD", text: "1.234 KWD"},
+		{amount: math.MaxInt64, code: "EUR", text: "92233720368547758.07 EUR"},
+		{amount: math.MinInt64, code: "EUR", text: "-92233720368547758.08 EUR"},
+		{amount: math.MinInt64, code: "JPY", text: "-9223372036854775808 JPY"},
+	} {
+		c, err := currency.NewCode(test.code)
+		assert.Nil(t, err)
+		ma := money.New(test.amount, *c)
+		tm, ok := ma.(encoding.TextMarshaler)
+		assert.True(t, ok)
+		bs, err := tm.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, test.text, string(bs))
+		mb, err := money.UnmarshalText(bs)
+		assert.Nil(t, err, test.text)
+		assert.Equal(t, ma, *mb)
+	}
+}
+
+func TestUnmarshalText_Invalid(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"12.34",
+		"12.34 EUR extra",
+		"12.34 TOO_LONG",
+		"12.345 EUR",
+		"12. EUR",
+		".5 EUR",
+		"1.5 JPY",
+		"12a.34 EUR",
+		"-- EUR",
+		"92233720368547758.08 EUR",
+	} {
+		_, err := money.UnmarshalText([]byte(text))
+		assert.NotNil(t, err, text)
+	}
+}
+
+func TestUnmarshalText_InvalidCurrency(t *testing.T) {
+	_, err := money.UnmarshalText([]byte("12.34 TOO_LONG"))
+	var lenErr currency.InvalidCodeLengthError
+	assert.True(t, errors.As(err, &lenErr), "%+v", err)
+	assert.Equal(t, len("TOO_LONG"), lenErr.Length)
+}
+
+func TestMarshalText_NilCurrency(t *testing.T) {
+	tm, ok := money.New(1, nil).(encoding.TextMarshaler)
+	assert.True(t, ok)
+	bs, err := tm.MarshalText()
+	assert.NotNil(t, err)
+	assert.Nil(t, bs)
+}
+
+func TestUnmarshalText_Overflow(t *testing.T) {
+	_, err := money.UnmarshalText([]byte("92233720368547758.08 EUR"))
+	var numErr *strconv.NumError
+	assert.True(t, errors.As(err, &numErr), "%+v", err)
+	assert.Equal(t, strconv.ErrRange, numErr.Err)
+}